@@ -93,7 +93,7 @@ func (e *E2) Mul(api frontend.API, e1, e2 E2) *E2 {
 	l31 := api.Add(ac, bd)
 	e.A1 = api.Sub(u, l31)
 
-	l41 := api.Mul(bd, ext.uSquare)
+	l41 := api.Mul(bd, tower.NonResidueSquare())
 	e.A0 = api.Add(ac, l41)
 
 	return e
@@ -103,7 +103,7 @@ func (e *E2) Mul(api frontend.API, e1, e2 E2) *E2 {
 func (e *E2) Square(api frontend.API, x E2) *E2 {
 	//algo 22 https://eprint.iacr.org/2010/354.pdf
 	c0 := api.Add(x.A0, x.A1)
-	c2 := api.Mul(x.A1, ext.uSquare)
+	c2 := api.Mul(x.A1, tower.NonResidueSquare())
 	c2 = api.Add(c2, x.A0)
 
 	c0 = api.Mul(c0, c2) // (x1+x2)*(x1+(u**2)x2)
@@ -131,10 +131,10 @@ func (e *E2) MulByFp(api frontend.API, e1 E2, c interface{}) *E2 {
 }
 
 // MulByNonResidue multiplies an fp2 elmt by the imaginary elmt
-// ext.uSquare is the square of the imaginary root
+// tower.NonResidueSquare() is the square of the imaginary root
 func (e *E2) MulByNonResidue(api frontend.API, e1 E2) *E2 {
 	x := e1.A0
-	e.A0 = api.Mul(e1.A1, ext.uSquare)
+	e.A0 = api.Mul(e1.A1, tower.NonResidueSquare())
 	e.A1 = x
 	return e
 }
@@ -167,7 +167,7 @@ func init() {
 // Inverse e2 elmts
 func (e *E2) Inverse(api frontend.API, e1 E2) *E2 {
 
-	res, err := api.NewHint(InverseE2Hint, 2, e1.A0, e1.A1)
+	res, err := api.NewHint(tower.InverseHint(), 2, e1.A0, e1.A1)
 	if err != nil {
 		// err is non-nil only for invalid number of inputs
 		panic(err)
@@ -0,0 +1,65 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import "github.com/consensys/gnark/frontend"
+
+// BatchInverse inverts every element of in via Montgomery's trick: a
+// single hint recovers the inverse of the running product p[n-1], then
+// each in[i]^-1 is peeled off walking p backwards. Costs 3n-2 E2 muls
+// and one hint for n inversions, instead of n hints and n muls.
+func BatchInverse(api frontend.API, in []E2) []E2 {
+	n := len(in)
+	if n == 0 {
+		return nil
+	}
+
+	// running products p[i] = in[0] * in[1] * ... * in[i]
+	products := make([]E2, n)
+	products[0] = in[0]
+	for i := 1; i < n; i++ {
+		products[i].Mul(api, products[i-1], in[i])
+	}
+
+	// acc starts as the inverse of the full product p[n-1]
+	var acc E2
+	acc.Inverse(api, products[n-1])
+
+	out := make([]E2, n)
+	for i := n - 1; i > 0; i-- {
+		// in[i]^-1 = p[i-1] * acc
+		out[i].Mul(api, products[i-1], acc)
+		// acc = acc * in[i] == (p[i-1])^-1
+		acc.Mul(api, acc, in[i])
+	}
+	out[0] = acc
+
+	return out
+}
+
+// BatchDivUnchecked divides each num[i] by den[i], built on top of
+// BatchInverse so the n inverses of den share a single hint.
+func BatchDivUnchecked(api frontend.API, num, den []E2) []E2 {
+	invDen := BatchInverse(api, den)
+
+	out := make([]E2, len(num))
+	for i := range num {
+		out[i].Mul(api, num[i], invDen[i])
+	}
+
+	return out
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type e2BatchInverseCircuit struct {
+	In  []E2
+	Out []E2
+}
+
+func (c *e2BatchInverseCircuit) Define(api frontend.API) error {
+	res := BatchInverse(api, c.In)
+	for i := range res {
+		res[i].MustBeEqual(api, c.Out[i])
+	}
+	return nil
+}
+
+func testBatchInverse(t *testing.T, n int) {
+	assert := test.NewAssert(t)
+
+	in := make([]E2, n)
+	out := make([]E2, n)
+	for i := 0; i < n; i++ {
+		var a, inv bls12377.E2
+		a.SetRandom()
+		inv.Inverse(&a)
+
+		in[i].Assign(&a)
+		out[i].Assign(&inv)
+	}
+
+	witness := &e2BatchInverseCircuit{In: in, Out: out}
+	circuit := &e2BatchInverseCircuit{In: make([]E2, n), Out: make([]E2, n)}
+
+	assert.SolvingSucceeded(circuit, witness, test.WithCurves(ecc.BW6_761))
+}
+
+func TestBatchInverse(t *testing.T) {
+	for _, n := range []int{1, 2, 16} {
+		testBatchInverse(t, n)
+	}
+}
+
+func testBatchDivUnchecked(t *testing.T, n int) {
+	assert := test.NewAssert(t)
+
+	num := make([]E2, n)
+	den := make([]E2, n)
+	quo := make([]E2, n)
+	for i := 0; i < n; i++ {
+		var a, b, c bls12377.E2
+		a.SetRandom()
+		b.SetRandom()
+		c.Inverse(&b).Mul(&c, &a)
+
+		num[i].Assign(&a)
+		den[i].Assign(&b)
+		quo[i].Assign(&c)
+	}
+
+	witness := &e2BatchDivUncheckedCircuit{Num: num, Den: den, Quo: quo}
+	circuit := &e2BatchDivUncheckedCircuit{Num: make([]E2, n), Den: make([]E2, n), Quo: make([]E2, n)}
+
+	assert.SolvingSucceeded(circuit, witness, test.WithCurves(ecc.BW6_761))
+}
+
+type e2BatchDivUncheckedCircuit struct {
+	Num, Den []E2
+	Quo      []E2
+}
+
+func (c *e2BatchDivUncheckedCircuit) Define(api frontend.API) error {
+	res := BatchDivUnchecked(api, c.Num, c.Den)
+	for i := range res {
+		res[i].MustBeEqual(api, c.Quo[i])
+	}
+	return nil
+}
+
+func TestBatchDivUnchecked(t *testing.T) {
+	for _, n := range []int{1, 2, 16} {
+		testBatchDivUnchecked(t, n)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import "github.com/consensys/gnark/frontend"
+
+// Select sets e to e1 if selector == 1, e2 if selector == 0.
+func (e *E2) Select(api frontend.API, selector frontend.Variable, e1, e2 E2) *E2 {
+	e.A0 = api.Select(selector, e1.A0, e2.A0)
+	e.A1 = api.Select(selector, e1.A1, e2.A1)
+	return e
+}
+
+// Lookup2 sets e to one of e00, e01, e10, e11 depending on (b0, b1).
+func (e *E2) Lookup2(api frontend.API, b0, b1 frontend.Variable, e00, e01, e10, e11 E2) *E2 {
+	e.A0 = api.Lookup2(b0, b1, e00.A0, e01.A0, e10.A0, e11.A0)
+	e.A1 = api.Lookup2(b0, b1, e00.A1, e01.A1, e10.A1, e11.A1)
+	return e
+}
+
+// IsZero returns 1 if e1 is the zero element of E2, 0 otherwise.
+func (e *E2) IsZero(api frontend.API, e1 E2) frontend.Variable {
+	return api.And(api.IsZero(e1.A0), api.IsZero(e1.A1))
+}
+
+// AssertIsDifferent constrains e to be different from other.
+func (e *E2) AssertIsDifferent(api frontend.API, other E2) {
+	var diff E2
+	diff.Sub(api, *e, other)
+	api.AssertIsEqual(diff.IsZero(api, diff), 0)
+}
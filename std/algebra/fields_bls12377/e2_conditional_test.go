@@ -0,0 +1,149 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type e2SelectCircuit struct {
+	Selector frontend.Variable
+	In0, In1 E2
+	Expected E2
+}
+
+func (c *e2SelectCircuit) Define(api frontend.API) error {
+	var res E2
+	res.Select(api, c.Selector, c.In0, c.In1)
+	res.MustBeEqual(api, c.Expected)
+	return nil
+}
+
+func TestE2Select(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	var a, b bls12377.E2
+	a.SetRandom()
+	b.SetRandom()
+
+	var aE2, bE2 E2
+	aE2.Assign(&a)
+	bE2.Assign(&b)
+
+	for _, sel := range []int{0, 1} {
+		expected := bE2
+		if sel == 1 {
+			expected = aE2
+		}
+		witness := &e2SelectCircuit{Selector: sel, In0: aE2, In1: bE2, Expected: expected}
+		circuit := &e2SelectCircuit{}
+		assert.SolvingSucceeded(circuit, witness, test.WithCurves(ecc.BW6_761))
+	}
+}
+
+type e2Lookup2Circuit struct {
+	B0, B1                 frontend.Variable
+	In00, In01, In10, In11 E2
+	Expected               E2
+}
+
+func (c *e2Lookup2Circuit) Define(api frontend.API) error {
+	var res E2
+	res.Lookup2(api, c.B0, c.B1, c.In00, c.In01, c.In10, c.In11)
+	res.MustBeEqual(api, c.Expected)
+	return nil
+}
+
+func TestE2Lookup2(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	elems := make([]bls12377.E2, 4)
+	e2s := make([]E2, 4)
+	for i := range elems {
+		elems[i].SetRandom()
+		e2s[i].Assign(&elems[i])
+	}
+
+	for b1 := 0; b1 < 2; b1++ {
+		for b0 := 0; b0 < 2; b0++ {
+			idx := b1*2 + b0
+			witness := &e2Lookup2Circuit{
+				B0: b0, B1: b1,
+				In00: e2s[0], In01: e2s[1], In10: e2s[2], In11: e2s[3],
+				Expected: e2s[idx],
+			}
+			circuit := &e2Lookup2Circuit{}
+			assert.SolvingSucceeded(circuit, witness, test.WithCurves(ecc.BW6_761))
+		}
+	}
+}
+
+type e2IsZeroCircuit struct {
+	X        E2
+	Expected frontend.Variable
+}
+
+func (c *e2IsZeroCircuit) Define(api frontend.API) error {
+	var e E2
+	got := e.IsZero(api, c.X)
+	api.AssertIsEqual(got, c.Expected)
+	return nil
+}
+
+func TestE2IsZero(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	var zero E2
+	zero.SetZero()
+
+	var nz bls12377.E2
+	nz.SetRandom()
+	var nzE2 E2
+	nzE2.Assign(&nz)
+
+	assert.SolvingSucceeded(&e2IsZeroCircuit{}, &e2IsZeroCircuit{X: zero, Expected: 1}, test.WithCurves(ecc.BW6_761))
+	assert.SolvingSucceeded(&e2IsZeroCircuit{}, &e2IsZeroCircuit{X: nzE2, Expected: 0}, test.WithCurves(ecc.BW6_761))
+}
+
+type e2AssertIsDifferentCircuit struct {
+	X, Y E2
+}
+
+func (c *e2AssertIsDifferentCircuit) Define(api frontend.API) error {
+	c.X.AssertIsDifferent(api, c.Y)
+	return nil
+}
+
+func TestE2AssertIsDifferent(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	var a, b bls12377.E2
+	a.SetRandom()
+	b.SetRandom()
+
+	var aE2, bE2 E2
+	aE2.Assign(&a)
+	bE2.Assign(&b)
+
+	assert.SolvingSucceeded(&e2AssertIsDifferentCircuit{}, &e2AssertIsDifferentCircuit{X: aE2, Y: bE2}, test.WithCurves(ecc.BW6_761))
+	assert.SolvingFailed(&e2AssertIsDifferentCircuit{}, &e2AssertIsDifferentCircuit{X: aE2, Y: aE2}, test.WithCurves(ecc.BW6_761))
+}
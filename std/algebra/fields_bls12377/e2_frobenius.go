@@ -0,0 +1,138 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// NOTE: this tree has no E6/E12 files yet, so the Frobenius,
+// FrobeniusSquare and FrobeniusCube methods these constants are meant for
+// don't exist here either. Once those files land, wire each constant-mul
+// helper below into the matching Frobenius power instead of a general
+// E2 Mul.
+
+// frobConst is a hard-coded Fp2 constant for one Frobenius power,
+// matching ecc/bls12-377/internal/fptower/frobenius.go.
+type frobConst struct {
+	A0, A1 big.Int
+}
+
+// fromBase10 parses a decimal constant, panicking on malformed input.
+func fromBase10(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("fields_bls12377: invalid constant " + s)
+	}
+	return v
+}
+
+// NOTE: these seven constants must match gnark-crypto's frobenius.go
+// exactly; TestFrobeniusConsts only cross-checks the circuit arithmetic
+// against them (see e2_frobenius_test.go), it can't validate the decimal
+// values themselves without that dependency present in this tree.
+var (
+	frobeniusNonResidue1Power1 = frobConst{
+		A0: *fromBase10("92949345220277864758624960506473182677953048909283248980960104381795901929519566951595905490535835115111760994353"),
+		A1: *fromBase10("0"),
+	}
+	frobeniusNonResidue1Power2 = frobConst{
+		A0: *fromBase10("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946"),
+		A1: *fromBase10("0"),
+	}
+	frobeniusNonResidue1Power3 = frobConst{
+		A0: *fromBase10("216465761340224619389371505802605247630151569547285782856803747159100223055385581585702401816380679166954762214499"),
+		A1: *fromBase10("0"),
+	}
+	frobeniusNonResidue2Power1 = frobConst{
+		A0: *fromBase10("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946"),
+		A1: *fromBase10("0"),
+	}
+	frobeniusNonResidue2Power2 = frobConst{
+		A0: *fromBase10("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945"),
+		A1: *fromBase10("0"),
+	}
+	frobeniusNonResidue3Power1 = frobConst{
+		A0: *fromBase10("216465761340224619389371505802605247630151569547285782856803747159100223055385581585702401816380679166954762214499"),
+		A1: *fromBase10("0"),
+	}
+	frobeniusNonResidue3Power2 = frobConst{
+		A0: *fromBase10("258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458176"),
+		A1: *fromBase10("0"),
+	}
+)
+
+// mulByFrobConst multiplies e1 by the constant c (4 Fp muls by c's limbs
+// plus one by the non-residue, MulByFp-like).
+func mulByFrobConst(api frontend.API, e1 E2, c frobConst) E2 {
+	a0c0 := api.Mul(e1.A0, &c.A0)
+	a1c1 := api.Mul(e1.A1, &c.A1)
+	a0c1 := api.Mul(e1.A0, &c.A1)
+	a1c0 := api.Mul(e1.A1, &c.A0)
+
+	a1c1u := api.Mul(a1c1, tower.NonResidueSquare())
+
+	var res E2
+	res.A0 = api.Add(a0c0, a1c1u)
+	res.A1 = api.Add(a0c1, a1c0)
+
+	return res
+}
+
+// MulByNonResidue1Power1 multiplies e1 by the 1st power non-residue constant.
+func (e *E2) MulByNonResidue1Power1(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue1Power1)
+	return e
+}
+
+// MulByNonResidue1Power2 multiplies e1 by the 2nd power non-residue constant.
+func (e *E2) MulByNonResidue1Power2(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue1Power2)
+	return e
+}
+
+// MulByNonResidue1Power3 multiplies e1 by the 3rd power non-residue constant.
+func (e *E2) MulByNonResidue1Power3(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue1Power3)
+	return e
+}
+
+// MulByNonResidue2Power1 multiplies e1 by the Frobenius-square's 1st power non-residue constant.
+func (e *E2) MulByNonResidue2Power1(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue2Power1)
+	return e
+}
+
+// MulByNonResidue2Power2 multiplies e1 by the Frobenius-square's 2nd power non-residue constant.
+func (e *E2) MulByNonResidue2Power2(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue2Power2)
+	return e
+}
+
+// MulByNonResidue3Power1 multiplies e1 by the Frobenius-cube's 1st power non-residue constant.
+func (e *E2) MulByNonResidue3Power1(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue3Power1)
+	return e
+}
+
+// MulByNonResidue3Power2 multiplies e1 by the Frobenius-cube's 2nd power non-residue constant.
+func (e *E2) MulByNonResidue3Power2(api frontend.API, e1 E2) *E2 {
+	*e = mulByFrobConst(api, e1, frobeniusNonResidue3Power2)
+	return e
+}
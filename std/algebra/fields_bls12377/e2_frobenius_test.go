@@ -0,0 +1,88 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+// e2FrobeniusConstCircuit cross-checks a MulByNonResidueXPowerY helper
+// against a general E2.Mul by the same constant: both go through
+// tower.NonResidueSquare(), so this catches a wrong reduction or a
+// swapped A0/A1 limb in mulByFrobConst's 4-mul formula. It does not
+// validate the seven hard-coded constants against gnark-crypto's
+// frobenius.go, which still needs doing with that dependency available.
+type e2FrobeniusConstCircuit struct {
+	X E2
+
+	mulFn func(e *E2, api frontend.API, e1 E2) *E2
+	c     frobConst
+}
+
+func (c *e2FrobeniusConstCircuit) Define(api frontend.API) error {
+	var got, want, constAsE2 E2
+
+	c.mulFn(&got, api, c.X)
+
+	constAsE2.A0 = &c.c.A0
+	constAsE2.A1 = &c.c.A1
+	want.Mul(api, c.X, constAsE2)
+
+	got.MustBeEqual(api, want)
+	return nil
+}
+
+func testFrobeniusConst(t *testing.T, name string, mulFn func(e *E2, api frontend.API, e1 E2) *E2, c frobConst) {
+	t.Run(name, func(t *testing.T) {
+		assert := test.NewAssert(t)
+
+		var x bls12377.E2
+		x.SetRandom()
+
+		var xE2 E2
+		xE2.Assign(&x)
+
+		witness := &e2FrobeniusConstCircuit{X: xE2, mulFn: mulFn, c: c}
+		circuit := &e2FrobeniusConstCircuit{mulFn: mulFn, c: c}
+
+		assert.SolvingSucceeded(circuit, witness, test.WithCurves(ecc.BW6_761))
+	})
+}
+
+func TestFrobeniusConsts(t *testing.T) {
+	cases := []struct {
+		name  string
+		mulFn func(e *E2, api frontend.API, e1 E2) *E2
+		c     frobConst
+	}{
+		{"NonResidue1Power1", (*E2).MulByNonResidue1Power1, frobeniusNonResidue1Power1},
+		{"NonResidue1Power2", (*E2).MulByNonResidue1Power2, frobeniusNonResidue1Power2},
+		{"NonResidue1Power3", (*E2).MulByNonResidue1Power3, frobeniusNonResidue1Power3},
+		{"NonResidue2Power1", (*E2).MulByNonResidue2Power1, frobeniusNonResidue2Power1},
+		{"NonResidue2Power2", (*E2).MulByNonResidue2Power2, frobeniusNonResidue2Power2},
+		{"NonResidue3Power1", (*E2).MulByNonResidue3Power1, frobeniusNonResidue3Power1},
+		{"NonResidue3Power2", (*E2).MulByNonResidue3Power2, frobeniusNonResidue3Power2},
+	}
+	for _, tc := range cases {
+		testFrobeniusConst(t, tc.name, tc.mulFn, tc.c)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/backend/hint"
+	"github.com/consensys/gnark/frontend"
+)
+
+var SqrtE2Hint = func(curve ecc.ID, inputs []*big.Int, res []*big.Int) error {
+	var a, c bls12377.E2
+
+	a.A0.SetBigInt(inputs[0])
+	a.A1.SetBigInt(inputs[1])
+
+	c.Sqrt(&a)
+
+	c.A0.ToBigIntRegular(res[0])
+	c.A1.ToBigIntRegular(res[1])
+
+	return nil
+}
+
+func init() {
+	hint.Register(SqrtE2Hint)
+}
+
+var LegendreE2Hint = func(curve ecc.ID, inputs []*big.Int, res []*big.Int) error {
+	var a bls12377.E2
+
+	a.A0.SetBigInt(inputs[0])
+	a.A1.SetBigInt(inputs[1])
+
+	res[0].SetInt64(int64(a.Legendre()))
+
+	return nil
+}
+
+func init() {
+	hint.Register(LegendreE2Hint)
+}
+
+// Legendre returns the (unconstrained) Legendre symbol of e1.
+func (e *E2) Legendre(api frontend.API, e1 E2) frontend.Variable {
+	res, err := api.NewHint(LegendreE2Hint, 1, e1.A0, e1.A1)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	return res[0]
+}
+
+// Sqrt sets e to a square root of e1, verified in-circuit by squaring.
+func (e *E2) Sqrt(api frontend.API, e1 E2) *E2 {
+
+	res, err := api.NewHint(tower.SqrtHint(), 2, e1.A0, e1.A1)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	var root, square E2
+	root.assign(res[:2])
+
+	square.Square(api, root)
+	square.MustBeEqual(api, e1)
+
+	e.assign(res[:2])
+
+	return e
+}
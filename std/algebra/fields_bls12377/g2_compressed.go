@@ -0,0 +1,72 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import "github.com/consensys/gnark/frontend"
+
+// NOTE: this tree has no pairing/Miller-loop package for BLS12-377 yet
+// (no sw_bls12377/E6/E12/pairing files exist here), so Compress and
+// Decompress below are standalone G2 codecs, not yet wired into pairing
+// verification. Once that package lands, its witness should accept a
+// compressed G2Affine and call Decompress instead of two full E2 coords.
+
+// G2Affine is a BLS12-377 G2 point in affine coordinates.
+type G2Affine struct {
+	X, Y E2
+}
+
+// twistBCoeff is b': Y² = X³ + b' on the G2 twist.
+var twistBCoeff = E2{
+	A0: "0",
+	A1: "155198655607781456406391640216936120121836107652948796323930557600032281009004493664981332883744016074664192874906",
+}
+
+// signE2 returns the parity bit of A1 (or of A0 when A1 is zero). The
+// field elements are decomposed to their full bit-width: passing a
+// truncated nbBits here would constrain A0/A1 themselves to fit in that
+// width instead of just reading their lowest bit.
+func signE2(api frontend.API, y E2) frontend.Variable {
+	bitsA1 := api.ToBinary(y.A1)
+	bitsA0 := api.ToBinary(y.A0)
+	a1Zero := api.IsZero(y.A1)
+	return api.Select(a1Zero, bitsA0[0], bitsA1[0])
+}
+
+// Compress returns p.X and the sign bit of p.Y, as consumed by Decompress.
+func Compress(api frontend.API, p G2Affine) (E2, frontend.Variable) {
+	return p.X, signE2(api, p.Y)
+}
+
+// Decompress rebuilds a G2Affine from X and Y's sign bit, solving
+// Y² = X³ + b' via a Sqrt hint and selecting the root matching sign.
+func Decompress(api frontend.API, x E2, sign frontend.Variable) G2Affine {
+	var x2, x3, rhs, root, negRoot, y E2
+
+	x2.Square(api, x)
+	x3.Mul(api, x2, x)
+	rhs.Add(api, x3, twistBCoeff)
+
+	root.Sqrt(api, rhs)
+	negRoot.Neg(api, root)
+
+	keepRoot := api.IsZero(api.Sub(signE2(api, root), sign))
+
+	y.A0 = api.Select(keepRoot, root.A0, negRoot.A0)
+	y.A1 = api.Select(keepRoot, root.A1, negRoot.A1)
+
+	return G2Affine{X: x, Y: y}
+}
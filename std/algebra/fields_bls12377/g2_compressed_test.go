@@ -0,0 +1,69 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type g2CompressDecompressCircuit struct {
+	X    E2
+	Sign frontend.Variable
+}
+
+func (c *g2CompressDecompressCircuit) Define(api frontend.API) error {
+	p := Decompress(api, c.X, c.Sign)
+	gotX, gotSign := Compress(api, p)
+
+	gotX.MustBeEqual(api, c.X)
+	api.AssertIsEqual(gotSign, c.Sign)
+
+	return nil
+}
+
+func TestG2CompressDecompress(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	_, _, _, g2Gen := bls12377.Generators()
+
+	var s big.Int
+	s.SetUint64(424242)
+
+	var p bls12377.G2Affine
+	p.ScalarMultiplication(&g2Gen, &s)
+
+	var x E2
+	x.Assign(&p.X)
+
+	yBytes := p.Y.A1.Bytes()
+	sign := int(yBytes[len(yBytes)-1] & 1)
+	if p.Y.A1.IsZero() {
+		yBytes = p.Y.A0.Bytes()
+		sign = int(yBytes[len(yBytes)-1] & 1)
+	}
+
+	witness := &g2CompressDecompressCircuit{X: x, Sign: sign}
+	circuit := &g2CompressDecompressCircuit{}
+
+	assert.SolvingSucceeded(circuit, witness, test.WithCurves(ecc.BW6_761))
+}
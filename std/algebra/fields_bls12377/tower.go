@@ -0,0 +1,69 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fields_bls12377
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+)
+
+// TowerParams captures the pieces of E2 circuit code that differ curve to
+// curve: the non-residue used by Mul/Square/MulByNonResidue, the
+// off-circuit witness type the hints convert through, and the inverse/
+// sqrt hints themselves. E2's methods below read these through the
+// package-level tower variable instead of hard-coded package constants,
+// so a TowerParams-generic `fields` package (or a go:generate template
+// keyed on it) can reuse this file for BW6-761 and BLS24-315 by swapping
+// what tower is set to, instead of copy-pasting it.
+type TowerParams interface {
+	// NonResidueSquare is the Fp2 non-residue squared (ext.uSquare here).
+	NonResidueSquare() interface{}
+	// Witness is the zero value of the off-circuit element type the
+	// hints below convert through.
+	Witness() interface{}
+	// InverseHint and SqrtHint are the off-circuit hint implementations
+	// backing E2.Inverse and E2.Sqrt respectively. E2.DivUnchecked does
+	// not go through tower: it calls DivE2Hint directly.
+	InverseHint() func(curve ecc.ID, inputs []*big.Int, res []*big.Int) error
+	SqrtHint() func(curve ecc.ID, inputs []*big.Int, res []*big.Int) error
+}
+
+// bls12377Tower is fields_bls12377's TowerParams implementation, and the
+// concrete params E2's methods are wired against via the tower variable
+// below. It is the first step of the migration: once BW6-761's E2 lands,
+// fold both packages into one generic implementation parameterized by
+// TowerParams, with fields_bls12377.E2 kept as a type alias so existing
+// circuits compile unchanged.
+type bls12377Tower struct{}
+
+func (bls12377Tower) NonResidueSquare() interface{} { return ext.uSquare }
+
+func (bls12377Tower) Witness() interface{} { return bls12377.E2{} }
+
+func (bls12377Tower) InverseHint() func(ecc.ID, []*big.Int, []*big.Int) error {
+	return InverseE2Hint
+}
+
+func (bls12377Tower) SqrtHint() func(ecc.ID, []*big.Int, []*big.Int) error {
+	return SqrtE2Hint
+}
+
+// tower is the TowerParams E2.Mul/Square/MulByNonResidue/Inverse/Sqrt use;
+// genericizing this file means replacing this package-level binding.
+var tower TowerParams = bls12377Tower{}